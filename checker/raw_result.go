@@ -0,0 +1,45 @@
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import "github.com/ossf/scorecard/v4/finding"
+
+// WorkflowFlavor identifies which CI system a workflow file was written
+// for. GitHub Actions, Forgejo, and Gitea all parse the same
+// actionlint-compatible YAML dialect, but downstream policy may still want
+// to know which one produced a given finding.
+type WorkflowFlavor int
+
+const (
+	// WorkflowFlavorGitHub is a workflow under .github/workflows.
+	WorkflowFlavorGitHub WorkflowFlavor = iota
+	// WorkflowFlavorForgejo is a workflow under .forgejo/workflows.
+	WorkflowFlavorForgejo
+	// WorkflowFlavorGitea is a workflow under .gitea/workflows.
+	WorkflowFlavorGitea
+)
+
+// File represents a file, and the location of an interesting part of it, if
+// applicable.
+type File struct {
+	Path    string
+	Type    finding.FileType
+	Offset  int
+	Snippet string
+	// Flavor records which CI system's workflow directory this file was
+	// found under, so DangerousWorkflow findings from Forgejo/Gitea mirrors
+	// can be attributed correctly. Zero value is WorkflowFlavorGitHub.
+	Flavor WorkflowFlavor
+}
@@ -0,0 +1,53 @@
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import "strings"
+
+// workflowExtensions are the file suffixes GitHub Actions, Forgejo, and
+// Gitea all use for workflow definitions.
+var workflowExtensions = []string{".yml", ".yaml"}
+
+// workflowDirRoots are the known CI workflow directories this helper
+// recognizes. It's deliberately scoped to these roots rather than any
+// directory named "workflows", so a file like src/workflows/build.yml
+// elsewhere in a repo isn't mistaken for a CI workflow definition.
+var workflowDirRoots = []string{".github/workflows/", ".forgejo/workflows/", ".gitea/workflows/"}
+
+// IsWorkflowFile returns true if path looks like a CI workflow definition
+// under one of the known GitHub Actions, Forgejo, or Gitea workflow
+// directories, which all share the same YAML extensions and directory
+// layout.
+func IsWorkflowFile(path string) bool {
+	lower := strings.ToLower(path)
+
+	hasWorkflowExt := false
+	for _, ext := range workflowExtensions {
+		if strings.HasSuffix(lower, ext) {
+			hasWorkflowExt = true
+			break
+		}
+	}
+	if !hasWorkflowExt {
+		return false
+	}
+
+	for _, root := range workflowDirRoots {
+		if strings.Contains(lower, root) {
+			return true
+		}
+	}
+	return false
+}
@@ -67,31 +67,69 @@ var (
 	checkoutUntrustedWorkflowRunRef = "github.event.workflow_run"
 )
 
+// workflowRoot pairs a directory Scorecard will probe for CI workflow
+// definitions with the flavor of CI system that uses it.
+type workflowRoot struct {
+	pattern string
+	flavor  checker.WorkflowFlavor
+}
+
+// workflowRoots lists the directories to probe, in the same order Forgejo
+// itself resolves them: a Forgejo mirror takes priority over Gitea, which
+// takes priority over the GitHub layout most repos already use. Forgejo and
+// Gitea both speak the same actionlint-compatible YAML dialect as GitHub
+// Actions, so the rest of this file doesn't need to special-case any of
+// them beyond recording which flavor a finding came from.
+var workflowRoots = []workflowRoot{
+	{pattern: ".forgejo/workflows/*", flavor: checker.WorkflowFlavorForgejo},
+	{pattern: ".gitea/workflows/*", flavor: checker.WorkflowFlavorGitea},
+	{pattern: ".github/workflows/*", flavor: checker.WorkflowFlavorGitHub},
+}
+
 // DangerousWorkflow retrieves the raw data for the DangerousWorkflow check.
 func DangerousWorkflow(c clients.RepoClient) (checker.DangerousWorkflowData, error) {
-	// data is shared across all GitHub workflows.
+	// data is shared across all workflows, regardless of which root they were found under.
 	var data checker.DangerousWorkflowData
 
-	v := &validateGitHubActionWorkflowPatterns{
-		client: c,
-	}
+	for _, root := range workflowRoots {
+		v := &validateGitHubActionWorkflowPatterns{
+			client: c,
+			flavor: root.flavor,
+		}
 
-	err := fileparser.OnMatchingFileContentDo(c, fileparser.PathMatcher{
-		Pattern:       ".github/workflows/*",
-		CaseSensitive: false,
-	}, v.Validate, &data)
+		if err := fileparser.OnMatchingFileContentDo(c, fileparser.PathMatcher{
+			Pattern:       root.pattern,
+			CaseSensitive: false,
+		}, v.Validate, &data); err != nil {
+			return data, err
+		}
+
+		// Mirroring Forgejo's own resolution order: once a root has produced
+		// workflow files, later, lower-priority roots are not consulted.
+		if v.matched {
+			break
+		}
+	}
 
-	return data, err
+	return data, nil
 }
 
 type validateGitHubActionWorkflowPatterns struct {
 	client clients.RepoClient
+	// flavor is the CI system workflowRoots scoped this validator to, so it
+	// can be attributed on every finding's checker.File.
+	flavor checker.WorkflowFlavor
+	// matched records whether Validate saw at least one workflow file under
+	// the root it was scoped to, so DangerousWorkflow can stop probing
+	// lower-priority roots.
+	matched bool
 }
 
 func (v *validateGitHubActionWorkflowPatterns) Validate(path string, content []byte, args ...interface{}) (bool, error) {
 	if !fileparser.IsWorkflowFile(path) {
 		return true, nil
 	}
+	v.matched = true
 
 	if len(args) != 1 {
 		return false, fmt.Errorf(
@@ -115,17 +153,17 @@ func (v *validateGitHubActionWorkflowPatterns) Validate(path string, content []b
 	}
 
 	// 1. Check for untrusted code checkout with pull_request_target and a ref
-	if err := validateUntrustedCodeCheckout(workflow, path, pdata); err != nil {
+	if err := validateUntrustedCodeCheckout(workflow, path, v.flavor, pdata); err != nil {
 		return false, err
 	}
 
 	// 2. Check for script injection in workflow inline scripts.
-	if err := validateScriptInjection(workflow, path, pdata); err != nil {
+	if err := validateScriptInjection(workflow, path, v.flavor, pdata); err != nil {
 		return false, err
 	}
 
 	// 3. Check for imposter commit references from forks
-	if err := validateImposterCommits(v.client, workflow, path, pdata); err != nil {
+	if err := validateImposterCommits(v.client, workflow, path, v.flavor, pdata); err != nil {
 		return false, err
 	}
 
@@ -134,14 +172,14 @@ func (v *validateGitHubActionWorkflowPatterns) Validate(path string, content []b
 }
 
 func validateUntrustedCodeCheckout(workflow *actionlint.Workflow, path string,
-	pdata *checker.DangerousWorkflowData,
+	flavor checker.WorkflowFlavor, pdata *checker.DangerousWorkflowData,
 ) error {
 	if !usesEventTrigger(workflow, triggerPullRequestTarget) && !usesEventTrigger(workflow, triggerWorkflowRun) {
 		return nil
 	}
 
 	for _, job := range workflow.Jobs {
-		if err := checkJobForUntrustedCodeCheckout(job, path, pdata); err != nil {
+		if err := checkJobForUntrustedCodeCheckout(job, path, flavor, pdata); err != nil {
 			return err
 		}
 	}
@@ -175,7 +213,7 @@ func createJob(job *actionlint.Job) *checker.WorkflowJob {
 }
 
 func checkJobForUntrustedCodeCheckout(job *actionlint.Job, path string,
-	pdata *checker.DangerousWorkflowData,
+	flavor checker.WorkflowFlavor, pdata *checker.DangerousWorkflowData,
 ) error {
 	if job == nil {
 		return nil
@@ -212,6 +250,7 @@ func checkJobForUntrustedCodeCheckout(job *actionlint.Job, path string,
 						Type:    finding.FileTypeSource,
 						Offset:  line,
 						Snippet: ref.Value.Value,
+						Flavor:  flavor,
 					},
 					Job: createJob(job),
 				},
@@ -222,7 +261,7 @@ func checkJobForUntrustedCodeCheckout(job *actionlint.Job, path string,
 }
 
 func validateScriptInjection(workflow *actionlint.Workflow, path string,
-	pdata *checker.DangerousWorkflowData,
+	flavor checker.WorkflowFlavor, pdata *checker.DangerousWorkflowData,
 ) error {
 	for _, job := range workflow.Jobs {
 		if job == nil {
@@ -237,7 +276,7 @@ func validateScriptInjection(workflow *actionlint.Workflow, path string,
 				continue
 			}
 			// Check Run *String for user-controllable (untrustworthy) properties.
-			if err := checkVariablesInScript(run.Run.Value, run.Run.Pos, job, path, pdata); err != nil {
+			if err := checkVariablesInScript(run.Run.Value, run.Run.Pos, job, path, flavor, pdata); err != nil {
 				return err
 			}
 		}
@@ -247,7 +286,7 @@ func validateScriptInjection(workflow *actionlint.Workflow, path string,
 
 func checkVariablesInScript(script string, pos *actionlint.Pos,
 	job *actionlint.Job, path string,
-	pdata *checker.DangerousWorkflowData,
+	flavor checker.WorkflowFlavor, pdata *checker.DangerousWorkflowData,
 ) error {
 	for {
 		s := strings.Index(script, "${{")
@@ -271,6 +310,7 @@ func checkVariablesInScript(script string, pos *actionlint.Pos,
 						Type:    finding.FileTypeSource,
 						Offset:  line,
 						Snippet: variable,
+						Flavor:  flavor,
 					},
 					Job:  createJob(job),
 					Type: checker.DangerousWorkflowScriptInjection,
@@ -283,7 +323,7 @@ func checkVariablesInScript(script string, pos *actionlint.Pos,
 }
 
 func validateImposterCommits(client clients.RepoClient, workflow *actionlint.Workflow, path string,
-	pdata *checker.DangerousWorkflowData,
+	flavor checker.WorkflowFlavor, pdata *checker.DangerousWorkflowData,
 ) error {
 	ctx := context.TODO()
 	cache := &containsCache{
@@ -318,6 +358,7 @@ func validateImposterCommits(client clients.RepoClient, workflow *actionlint.Wor
 								Type:    finding.FileTypeSource,
 								Offset:  fileparser.GetLineNumber(step.Pos),
 								Snippet: trimmedRef,
+								Flavor:  flavor,
 							},
 							Job:  createJob(job),
 							Type: checker.DangerousWorkflowImposterReference,
@@ -0,0 +1,74 @@
+// Copyright 2021 Security Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githubrepo
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/shurcooL/githubv4"
+
+	"github.com/ossf/scorecard/v2/clients"
+)
+
+// Client implements clients.RepoClient against the GitHub GraphQL API v4.
+// It's the real entry point through which callers reach graphqlHandler, so
+// the pagination options accepted by InitRepo actually flow into a live
+// query instead of only being reachable from this package's own tests.
+type Client struct {
+	ctx     context.Context
+	graphql *graphqlHandler
+	opts    []clients.RepoClientOption
+}
+
+// CreateGithubRepoClient returns a clients.RepoClient backed by the GitHub
+// GraphQL API. opts are applied to every InitRepo call made on the
+// returned client, letting callers trade cost for completeness, e.g.
+// CreateGithubRepoClient(ctx, httpClient, clients.WithMaxPullRequests(200)).
+func CreateGithubRepoClient(ctx context.Context, httpClient *http.Client, opts ...clients.RepoClientOption) clients.RepoClient {
+	return &Client{
+		ctx:     ctx,
+		graphql: &graphqlHandler{client: githubv4.NewClient(httpClient)},
+		opts:    opts,
+	}
+}
+
+// InitRepo prepares the client to query owner/repo. opts are appended to
+// the options the client was created with, so a single call can raise a
+// ceiling just for this repo without affecting the client's defaults.
+func (c *Client) InitRepo(owner, repo string, opts ...clients.RepoClientOption) error {
+	c.graphql.init(c.ctx, owner, repo, append(append([]clients.RepoClientOption{}, c.opts...), opts...)...)
+	return nil
+}
+
+// ListCommits implements clients.RepoClient.ListCommits.
+func (c *Client) ListCommits() ([]clients.Commit, error) {
+	return c.graphql.getCommits()
+}
+
+// ListMergedPRs implements clients.RepoClient.ListMergedPRs.
+func (c *Client) ListMergedPRs() ([]clients.PullRequest, error) {
+	return c.graphql.getMergedPRs()
+}
+
+// ListReleases implements clients.RepoClient.ListReleases.
+func (c *Client) ListReleases() ([]clients.Release, error) {
+	return c.graphql.getReleases()
+}
+
+// IsArchived implements clients.RepoClient.IsArchived.
+func (c *Client) IsArchived() (bool, error) {
+	return c.graphql.isArchived()
+}
@@ -0,0 +1,52 @@
+// Copyright 2021 Security Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githubrepo
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/ossf/scorecard/v2/clients"
+)
+
+// TestCreateGithubRepoClient_PaginatesBeyondThirtyMergedPRs exercises the
+// same >30-merged-PRs scenario as TestGraphqlHandler_PaginatesBeyondThirtyMergedPRs,
+// but through the public clients.RepoClient entry point
+// (CreateGithubRepoClient + InitRepo) rather than the internal handler
+// directly, proving a real caller can actually reach the raised ceiling.
+func TestCreateGithubRepoClient_PaginatesBeyondThirtyMergedPRs(t *testing.T) {
+	t.Parallel()
+
+	transport := &pagedPullRequestsTransport{}
+	httpClient := &http.Client{Transport: transport}
+
+	repoClient := CreateGithubRepoClient(context.Background(), httpClient, clients.WithMaxPullRequests(100))
+	if err := repoClient.InitRepo("owner", "repo"); err != nil {
+		t.Fatalf("InitRepo: %v", err)
+	}
+
+	prs, err := repoClient.ListMergedPRs()
+	if err != nil {
+		t.Fatalf("ListMergedPRs: %v", err)
+	}
+
+	if len(prs) != 45 {
+		t.Errorf("got %d merged PRs, want 45 (the historical cutoff would have returned 30)", len(prs))
+	}
+	if transport.calls < 2 {
+		t.Errorf("got %d request(s), want at least 2: the client should have followed the pageInfo cursor", transport.calls)
+	}
+}
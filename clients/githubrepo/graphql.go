@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/shurcooL/githubv4"
 
@@ -25,15 +26,100 @@ import (
 	sce "github.com/ossf/scorecard/v2/errors"
 )
 
+// Ceilings used when the caller doesn't supply its own through
+// clients.RepoClient. These match the historical hard-coded page size, so a
+// caller that doesn't opt in to a higher ceiling gets no more items of each
+// kind than before. Which items are kept can differ: merged pull requests
+// come back newest-first, and release assets are taken from the first page
+// rather than the last.
 const (
-	pullRequestsToAnalyze  = 30
-	reviewsToAnalyze       = 30
-	labelsToAnalyze        = 30
-	commitsToAnalyze       = 30
-	releasesToAnalyze      = 30
-	releaseAssetsToAnalyze = 30
+	defaultPullRequestsToAnalyze  = 30
+	defaultReviewsToAnalyze       = 30
+	defaultLabelsToAnalyze        = 30
+	defaultCommitsToAnalyze       = 30
+	defaultReleasesToAnalyze      = 30
+	defaultReleaseAssetsToAnalyze = 30
+
+	// pageSize is how many items we request per round-trip while paginating
+	// a connection. It's independent of the ceiling: a 500-commit ceiling is
+	// still fetched 100 commits at a time.
+	pageSize = 100
 )
 
+// pageInfo mirrors the GraphQL PageInfo type so every connection can be
+// paginated with the same cursor-following logic.
+// nolint: govet
+type pageInfo struct {
+	HasNextPage githubv4.Boolean
+	EndCursor   githubv4.String
+}
+
+// nolint: govet
+type commitNode struct {
+	CommittedDate githubv4.DateTime
+	Message       githubv4.String
+	Oid           githubv4.GitObjectID
+	Committer     struct {
+		User struct {
+			Login githubv4.String
+		}
+	}
+}
+
+// nolint: govet
+type labelNode struct {
+	Name githubv4.String
+}
+
+// nolint: govet
+type reviewNode struct {
+	State githubv4.String
+}
+
+// nolint: govet
+type releaseAssetNode struct {
+	Name githubv4.String
+	URL  githubv4.String
+}
+
+// nolint: govet
+type pullRequestNode struct {
+	ID          githubv4.ID
+	Number      githubv4.Int
+	HeadRefOid  githubv4.String
+	MergeCommit struct {
+		AuthoredByCommitter githubv4.Boolean
+	}
+	MergedAt githubv4.DateTime
+	// Neither connection supports an orderBy argument. The seed query asks
+	// for the last pageSize labels/reviews, and paginateLabels and
+	// paginateReviews keep the first ceiling of what was fetched. A PR with
+	// no more labels/reviews than the ceiling therefore gets the same set as
+	// the historical last-30 query; a PR with more gets a different subset.
+	// The continuation queries below use first/after from the seed page's
+	// endCursor.
+	Labels struct {
+		Nodes    []labelNode
+		PageInfo pageInfo
+	} `graphql:"labels(last: $pageSize)"`
+	LatestReviews struct {
+		Nodes    []reviewNode
+		PageInfo pageInfo
+	} `graphql:"latestReviews(last: $pageSize)"`
+}
+
+// nolint: govet
+type releaseNode struct {
+	ID            githubv4.ID
+	TagName       githubv4.String
+	ReleaseAssets struct {
+		Nodes    []releaseAssetNode
+		PageInfo pageInfo
+	} `graphql:"releaseAssets(first: $pageSize)"`
+}
+
+// graphqlData seeds every connection we care about with its first page, plus
+// enough pageInfo to know whether a follow-up query is needed.
 // nolint: govet
 type graphqlData struct {
 	Repository struct {
@@ -42,131 +128,317 @@ type graphqlData struct {
 			Target struct {
 				Commit struct {
 					History struct {
-						Nodes []struct {
-							CommittedDate githubv4.DateTime
-							Message       githubv4.String
-							Oid           githubv4.GitObjectID
-							Committer     struct {
-								User struct {
-									Login githubv4.String
-								}
-							}
-						}
-					} `graphql:"history(first: $commitsToAnalyze)"`
+						Nodes    []commitNode
+						PageInfo pageInfo
+					} `graphql:"history(first: $pageSize)"`
 				} `graphql:"... on Commit"`
 			}
 		}
 		PullRequests struct {
-			Nodes []struct {
-				Number      githubv4.Int
-				HeadRefOid  githubv4.String
-				MergeCommit struct {
-					AuthoredByCommitter githubv4.Boolean
-				}
-				MergedAt githubv4.DateTime
-				Labels   struct {
-					Nodes []struct {
-						Name githubv4.String
-					}
-				} `graphql:"labels(last: $labelsToAnalyze)"`
-				LatestReviews struct {
-					Nodes []struct {
-						State githubv4.String
-					}
-				} `graphql:"latestReviews(last: $reviewsToAnalyze)"`
-			}
-		} `graphql:"pullRequests(last: $pullRequestsToAnalyze, states: MERGED)"`
+			Nodes    []pullRequestNode
+			PageInfo pageInfo
+		} `graphql:"pullRequests(first: $pageSize, states: MERGED, orderBy:{field: CREATED_AT, direction:DESC})"`
 		Releases struct {
-			Nodes []struct {
-				TagName       githubv4.String
-				ReleaseAssets struct {
-					Nodes []struct {
-						Name githubv4.String
-						URL  githubv4.String
-					}
-				} `graphql:"releaseAssets(last: $releaseAssetsToAnalyze)"`
+			Nodes    []releaseNode
+			PageInfo pageInfo
+		} `graphql:"releases(first: $pageSize, orderBy:{field: CREATED_AT, direction:DESC})"`
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
+// commitsQuery fetches subsequent pages of the default branch's commit
+// history.
+// nolint: govet
+type commitsQuery struct {
+	Repository struct {
+		DefaultBranchRef struct {
+			Target struct {
+				Commit struct {
+					History struct {
+						Nodes    []commitNode
+						PageInfo pageInfo
+					} `graphql:"history(first: $pageSize, after: $cursor)"`
+				} `graphql:"... on Commit"`
 			}
-		} `graphql:"releases(first: $releasesToAnalyze, orderBy:{field: CREATED_AT, direction:DESC})"`
+		}
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
+// pullRequestsQuery fetches subsequent pages of merged pull requests.
+// nolint: govet
+type pullRequestsQuery struct {
+	Repository struct {
+		PullRequests struct {
+			Nodes    []pullRequestNode
+			PageInfo pageInfo
+		} `graphql:"pullRequests(first: $pageSize, after: $cursor, states: MERGED, orderBy:{field: CREATED_AT, direction:DESC})"`
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
+// releasesQuery fetches subsequent pages of releases.
+// nolint: govet
+type releasesQuery struct {
+	Repository struct {
+		Releases struct {
+			Nodes    []releaseNode
+			PageInfo pageInfo
+		} `graphql:"releases(first: $pageSize, after: $cursor, orderBy:{field: CREATED_AT, direction:DESC})"`
 	} `graphql:"repository(owner: $owner, name: $name)"`
 }
 
+// labelsQuery fetches subsequent pages of labels for a single pull request,
+// looked up by node ID so we don't have to re-walk the whole PR connection.
+// nolint: govet
+type labelsQuery struct {
+	Node struct {
+		PullRequest struct {
+			Labels struct {
+				Nodes    []labelNode
+				PageInfo pageInfo
+			} `graphql:"labels(first: $pageSize, after: $cursor)"`
+		} `graphql:"... on PullRequest"`
+	} `graphql:"node(id: $id)"`
+}
+
+// reviewsQuery fetches subsequent pages of reviews for a single pull
+// request.
+// nolint: govet
+type reviewsQuery struct {
+	Node struct {
+		PullRequest struct {
+			LatestReviews struct {
+				Nodes    []reviewNode
+				PageInfo pageInfo
+			} `graphql:"latestReviews(first: $pageSize, after: $cursor)"`
+		} `graphql:"... on PullRequest"`
+	} `graphql:"node(id: $id)"`
+}
+
+// releaseAssetsQuery fetches subsequent pages of assets for a single
+// release.
+// nolint: govet
+type releaseAssetsQuery struct {
+	Node struct {
+		Release struct {
+			ReleaseAssets struct {
+				Nodes    []releaseAssetNode
+				PageInfo pageInfo
+			} `graphql:"releaseAssets(first: $pageSize, after: $cursor)"`
+		} `graphql:"... on Release"`
+	} `graphql:"node(id: $id)"`
+}
+
+// paginationCeilings caps how many items of each kind graphqlHandler will
+// fetch. A zero value means "use the package default", which preserves the
+// historical 30-item behavior for callers that don't opt in to more. These
+// are populated from the clients.RepoClientOption values (e.g.
+// clients.WithMaxCommits) passed to init, so callers can trade cost for
+// completeness.
+type paginationCeilings struct {
+	maxPullRequests  int
+	maxCommits       int
+	maxReleases      int
+	maxLabels        int
+	maxReviews       int
+	maxReleaseAssets int
+}
+
+func (p paginationCeilings) pullRequests() int {
+	return withDefault(p.maxPullRequests, defaultPullRequestsToAnalyze)
+}
+func (p paginationCeilings) commits() int { return withDefault(p.maxCommits, defaultCommitsToAnalyze) }
+func (p paginationCeilings) releases() int {
+	return withDefault(p.maxReleases, defaultReleasesToAnalyze)
+}
+func (p paginationCeilings) labels() int  { return withDefault(p.maxLabels, defaultLabelsToAnalyze) }
+func (p paginationCeilings) reviews() int { return withDefault(p.maxReviews, defaultReviewsToAnalyze) }
+
+func (p paginationCeilings) releaseAssets() int {
+	return withDefault(p.maxReleaseAssets, defaultReleaseAssetsToAnalyze)
+}
+
+func withDefault(v, def int) int {
+	if v <= 0 {
+		return def
+	}
+	return v
+}
+
 type graphqlHandler struct {
-	client   *githubv4.Client
-	data     *graphqlData
-	once     *sync.Once
-	ctx      context.Context
-	errSetup error
-	owner    string
-	repo     string
-	prs      []clients.PullRequest
-	commits  []clients.Commit
-	releases []clients.Release
-	archived bool
-}
-
-func (handler *graphqlHandler) init(ctx context.Context, owner, repo string) {
+	client         *githubv4.Client
+	data           *graphqlData
+	once           *sync.Once
+	ctx            context.Context
+	errSetup       error
+	owner          string
+	repo           string
+	prs            []clients.PullRequest
+	commits        []clients.Commit
+	releases       []clients.Release
+	archived       bool
+	ceilings       paginationCeilings
+	lookbackCutoff time.Time
+}
+
+// init prepares the handler to query owner/repo. opts lets the caller raise
+// the default pagination ceilings (e.g. clients.WithMaxCommits) or bound
+// commit history by age (clients.WithLookbackDuration) instead of settling
+// for the historical 30-item cutoff.
+func (handler *graphqlHandler) init(ctx context.Context, owner, repo string, opts ...clients.RepoClientOption) {
 	handler.ctx = ctx
 	handler.owner = owner
 	handler.repo = repo
 	handler.data = new(graphqlData)
 	handler.errSetup = nil
 	handler.once = new(sync.Once)
+
+	o := clients.Apply(opts...)
+	handler.ceilings = paginationCeilings{
+		maxPullRequests:  o.MaxPullRequests,
+		maxCommits:       o.MaxCommits,
+		maxReleases:      o.MaxReleases,
+		maxLabels:        o.MaxLabels,
+		maxReviews:       o.MaxReviews,
+		maxReleaseAssets: o.MaxReleaseAssets,
+	}
+	if o.LookbackDuration > 0 {
+		handler.lookbackCutoff = time.Now().Add(-o.LookbackDuration)
+	}
 }
 
 func (handler *graphqlHandler) setup() error {
 	handler.once.Do(func() {
 		vars := map[string]interface{}{
-			"owner":                  githubv4.String(handler.owner),
-			"name":                   githubv4.String(handler.repo),
-			"pullRequestsToAnalyze":  githubv4.Int(pullRequestsToAnalyze),
-			"reviewsToAnalyze":       githubv4.Int(reviewsToAnalyze),
-			"labelsToAnalyze":        githubv4.Int(labelsToAnalyze),
-			"commitsToAnalyze":       githubv4.Int(commitsToAnalyze),
-			"releasesToAnalyze":      githubv4.Int(releasesToAnalyze),
-			"releaseAssetsToAnalyze": githubv4.Int(releaseAssetsToAnalyze),
+			"owner":    githubv4.String(handler.owner),
+			"name":     githubv4.String(handler.repo),
+			"pageSize": githubv4.Int(pageSize),
 		}
 		if err := handler.client.Query(handler.ctx, handler.data, vars); err != nil {
 			handler.errSetup = sce.Create(sce.ErrScorecardInternal, fmt.Sprintf("githubv4.Query: %v", err))
+			return
 		}
 		handler.archived = bool(handler.data.Repository.IsArchived)
-		handler.prs = pullRequestsFrom(handler.data)
-		handler.releases = releasesFrom(handler.data)
-		handler.commits = commitsFrom(handler.data)
+
+		commits, err := handler.paginateCommits()
+		if err != nil {
+			handler.errSetup = err
+			return
+		}
+		handler.commits = commits
+
+		prs, err := handler.paginatePullRequests()
+		if err != nil {
+			handler.errSetup = err
+			return
+		}
+		handler.prs = prs
+
+		releases, err := handler.paginateReleases()
+		if err != nil {
+			handler.errSetup = err
+			return
+		}
+		handler.releases = releases
 	})
 	return handler.errSetup
 }
 
-func (handler *graphqlHandler) getMergedPRs() ([]clients.PullRequest, error) {
-	if err := handler.setup(); err != nil {
-		return nil, fmt.Errorf("error during graphqlHandler.setup: %w", err)
+// paginateCommits follows the default branch's commit history cursor until
+// the connection is exhausted, the configured ceiling is reached, or (with
+// clients.WithLookbackDuration) commits age past the lookback window.
+// History is returned newest-first, so the lookback cutoff can stop the
+// walk as soon as it's crossed rather than filtering the whole page.
+func (handler *graphqlHandler) paginateCommits() ([]clients.Commit, error) {
+	ceiling := handler.ceilings.commits()
+	page := handler.data.Repository.DefaultBranchRef.Target.Commit.History
+	nodes := append([]commitNode{}, page.Nodes...)
+	info := page.PageInfo
+
+	for bool(info.HasNextPage) && len(nodes) < ceiling && !pastLookback(nodes, handler.lookbackCutoff) {
+		var q commitsQuery
+		vars := map[string]interface{}{
+			"owner":    githubv4.String(handler.owner),
+			"name":     githubv4.String(handler.repo),
+			"pageSize": githubv4.Int(pageSize),
+			"cursor":   info.EndCursor,
+		}
+		if err := handler.client.Query(handler.ctx, &q, vars); err != nil {
+			return nil, sce.Create(sce.ErrScorecardInternal, fmt.Sprintf("githubv4.Query commits: %v", err))
+		}
+		next := q.Repository.DefaultBranchRef.Target.Commit.History
+		nodes = append(nodes, next.Nodes...)
+		info = next.PageInfo
 	}
-	return handler.prs, nil
-}
+	if len(nodes) > ceiling {
+		nodes = nodes[:ceiling]
+	}
+	nodes = trimToLookback(nodes, handler.lookbackCutoff)
 
-func (handler *graphqlHandler) getCommits() ([]clients.Commit, error) {
-	if err := handler.setup(); err != nil {
-		return nil, fmt.Errorf("error during graphqlHandler.setup: %w", err)
+	ret := make([]clients.Commit, 0, len(nodes))
+	for _, commit := range nodes {
+		ret = append(ret, clients.Commit{
+			CommittedDate: commit.CommittedDate.Time,
+			Message:       string(commit.Message),
+			SHA:           string(commit.Oid),
+			Committer: clients.User{
+				Login: string(commit.Committer.User.Login),
+			},
+		})
 	}
-	return handler.commits, nil
+	return ret, nil
 }
 
-func (handler *graphqlHandler) getReleases() ([]clients.Release, error) {
-	if err := handler.setup(); err != nil {
-		return nil, fmt.Errorf("error during graphqlHandler.setup: %w", err)
+// pastLookback reports whether the oldest commit fetched so far is already
+// older than cutoff, meaning another round trip would only fetch commits
+// we're going to discard anyway.
+func pastLookback(nodes []commitNode, cutoff time.Time) bool {
+	if cutoff.IsZero() || len(nodes) == 0 {
+		return false
 	}
-	return handler.releases, nil
+	return nodes[len(nodes)-1].CommittedDate.Time.Before(cutoff)
 }
 
-func (handler *graphqlHandler) isArchived() (bool, error) {
-	if err := handler.setup(); err != nil {
-		return false, fmt.Errorf("error during graphqlHandler.setup: %w", err)
+// trimToLookback drops commits older than cutoff from the (newest-first)
+// slice. A zero cutoff disables the filter.
+func trimToLookback(nodes []commitNode, cutoff time.Time) []commitNode {
+	if cutoff.IsZero() {
+		return nodes
 	}
-	return handler.archived, nil
+	for i, n := range nodes {
+		if n.CommittedDate.Time.Before(cutoff) {
+			return nodes[:i]
+		}
+	}
+	return nodes
 }
 
-func pullRequestsFrom(data *graphqlData) []clients.PullRequest {
-	ret := make([]clients.PullRequest, len(data.Repository.PullRequests.Nodes))
-	for i, pr := range data.Repository.PullRequests.Nodes {
+// paginatePullRequests follows the merged pull request cursor, and for each
+// pull request also follows its labels and reviews cursors if present.
+func (handler *graphqlHandler) paginatePullRequests() ([]clients.PullRequest, error) {
+	ceiling := handler.ceilings.pullRequests()
+	page := handler.data.Repository.PullRequests
+	nodes := append([]pullRequestNode{}, page.Nodes...)
+	info := page.PageInfo
+
+	for bool(info.HasNextPage) && len(nodes) < ceiling {
+		var q pullRequestsQuery
+		vars := map[string]interface{}{
+			"owner":    githubv4.String(handler.owner),
+			"name":     githubv4.String(handler.repo),
+			"pageSize": githubv4.Int(pageSize),
+			"cursor":   info.EndCursor,
+		}
+		if err := handler.client.Query(handler.ctx, &q, vars); err != nil {
+			return nil, sce.Create(sce.ErrScorecardInternal, fmt.Sprintf("githubv4.Query pullRequests: %v", err))
+		}
+		nodes = append(nodes, q.Repository.PullRequests.Nodes...)
+		info = q.Repository.PullRequests.PageInfo
+	}
+	if len(nodes) > ceiling {
+		nodes = nodes[:ceiling]
+	}
+
+	ret := make([]clients.PullRequest, len(nodes))
+	for i, pr := range nodes {
 		toAppend := clients.PullRequest{
 			Number:   int(pr.Number),
 			HeadSHA:  string(pr.HeadRefOid),
@@ -175,50 +447,179 @@ func pullRequestsFrom(data *graphqlData) []clients.PullRequest {
 				AuthoredByCommitter: bool(pr.MergeCommit.AuthoredByCommitter),
 			},
 		}
-		for _, label := range pr.Labels.Nodes {
-			toAppend.Labels = append(toAppend.Labels, clients.Label{
-				Name: string(label.Name),
-			})
+
+		labels, err := handler.paginateLabels(pr)
+		if err != nil {
+			return nil, err
 		}
-		for _, review := range pr.LatestReviews.Nodes {
-			toAppend.Reviews = append(toAppend.Reviews, clients.Review{
-				State: string(review.State),
-			})
+		toAppend.Labels = labels
+
+		reviews, err := handler.paginateReviews(pr)
+		if err != nil {
+			return nil, err
 		}
+		toAppend.Reviews = reviews
+
 		ret[i] = toAppend
 	}
-	return ret
+	return ret, nil
+}
+
+func (handler *graphqlHandler) paginateLabels(pr pullRequestNode) ([]clients.Label, error) {
+	ceiling := handler.ceilings.labels()
+	nodes := append([]labelNode{}, pr.Labels.Nodes...)
+	info := pr.Labels.PageInfo
+
+	for bool(info.HasNextPage) && len(nodes) < ceiling {
+		var q labelsQuery
+		vars := map[string]interface{}{
+			"id":       pr.ID,
+			"pageSize": githubv4.Int(pageSize),
+			"cursor":   info.EndCursor,
+		}
+		if err := handler.client.Query(handler.ctx, &q, vars); err != nil {
+			return nil, sce.Create(sce.ErrScorecardInternal, fmt.Sprintf("githubv4.Query labels: %v", err))
+		}
+		nodes = append(nodes, q.Node.PullRequest.Labels.Nodes...)
+		info = q.Node.PullRequest.Labels.PageInfo
+	}
+	if len(nodes) > ceiling {
+		nodes = nodes[:ceiling]
+	}
+
+	var labels []clients.Label
+	for _, l := range nodes {
+		labels = append(labels, clients.Label{Name: string(l.Name)})
+	}
+	return labels, nil
+}
+
+func (handler *graphqlHandler) paginateReviews(pr pullRequestNode) ([]clients.Review, error) {
+	ceiling := handler.ceilings.reviews()
+	nodes := append([]reviewNode{}, pr.LatestReviews.Nodes...)
+	info := pr.LatestReviews.PageInfo
+
+	for bool(info.HasNextPage) && len(nodes) < ceiling {
+		var q reviewsQuery
+		vars := map[string]interface{}{
+			"id":       pr.ID,
+			"pageSize": githubv4.Int(pageSize),
+			"cursor":   info.EndCursor,
+		}
+		if err := handler.client.Query(handler.ctx, &q, vars); err != nil {
+			return nil, sce.Create(sce.ErrScorecardInternal, fmt.Sprintf("githubv4.Query reviews: %v", err))
+		}
+		nodes = append(nodes, q.Node.PullRequest.LatestReviews.Nodes...)
+		info = q.Node.PullRequest.LatestReviews.PageInfo
+	}
+	if len(nodes) > ceiling {
+		nodes = nodes[:ceiling]
+	}
+
+	var reviews []clients.Review
+	for _, r := range nodes {
+		reviews = append(reviews, clients.Review{State: string(r.State)})
+	}
+	return reviews, nil
 }
 
-func releasesFrom(data *graphqlData) []clients.Release {
+// paginateReleases follows the releases cursor, and for each release also
+// follows its release asset cursor if present.
+func (handler *graphqlHandler) paginateReleases() ([]clients.Release, error) {
+	ceiling := handler.ceilings.releases()
+	page := handler.data.Repository.Releases
+	nodes := append([]releaseNode{}, page.Nodes...)
+	info := page.PageInfo
+
+	for bool(info.HasNextPage) && len(nodes) < ceiling {
+		var q releasesQuery
+		vars := map[string]interface{}{
+			"owner":    githubv4.String(handler.owner),
+			"name":     githubv4.String(handler.repo),
+			"pageSize": githubv4.Int(pageSize),
+			"cursor":   info.EndCursor,
+		}
+		if err := handler.client.Query(handler.ctx, &q, vars); err != nil {
+			return nil, sce.Create(sce.ErrScorecardInternal, fmt.Sprintf("githubv4.Query releases: %v", err))
+		}
+		nodes = append(nodes, q.Repository.Releases.Nodes...)
+		info = q.Repository.Releases.PageInfo
+	}
+	if len(nodes) > ceiling {
+		nodes = nodes[:ceiling]
+	}
+
 	// nolint: prealloc // https://github.com/golang/go/wiki/CodeReviewComments#declaring-empty-slices
 	var releases []clients.Release
-	for _, r := range data.Repository.Releases.Nodes {
-		release := clients.Release{
+	for _, r := range nodes {
+		assets, err := handler.paginateReleaseAssets(r)
+		if err != nil {
+			return nil, err
+		}
+		releases = append(releases, clients.Release{
 			TagName: string(r.TagName),
+			Assets:  assets,
+		})
+	}
+	return releases, nil
+}
+
+func (handler *graphqlHandler) paginateReleaseAssets(release releaseNode) ([]clients.ReleaseAsset, error) {
+	ceiling := handler.ceilings.releaseAssets()
+	nodes := append([]releaseAssetNode{}, release.ReleaseAssets.Nodes...)
+	info := release.ReleaseAssets.PageInfo
+
+	for bool(info.HasNextPage) && len(nodes) < ceiling {
+		var q releaseAssetsQuery
+		vars := map[string]interface{}{
+			"id":       release.ID,
+			"pageSize": githubv4.Int(pageSize),
+			"cursor":   info.EndCursor,
 		}
-		for _, a := range r.ReleaseAssets.Nodes {
-			release.Assets = append(release.Assets, clients.ReleaseAsset{
-				Name: string(a.Name),
-				URL:  string(a.URL),
-			})
+		if err := handler.client.Query(handler.ctx, &q, vars); err != nil {
+			return nil, sce.Create(sce.ErrScorecardInternal, fmt.Sprintf("githubv4.Query releaseAssets: %v", err))
 		}
-		releases = append(releases, release)
+		nodes = append(nodes, q.Node.Release.ReleaseAssets.Nodes...)
+		info = q.Node.Release.ReleaseAssets.PageInfo
+	}
+	if len(nodes) > ceiling {
+		nodes = nodes[:ceiling]
 	}
-	return releases
-}
 
-func commitsFrom(data *graphqlData) []clients.Commit {
-	ret := make([]clients.Commit, 0)
-	for _, commit := range data.Repository.DefaultBranchRef.Target.Commit.History.Nodes {
-		ret = append(ret, clients.Commit{
-			CommittedDate: commit.CommittedDate.Time,
-			Message:       string(commit.Message),
-			SHA:           string(commit.Oid),
-			Committer: clients.User{
-				Login: string(commit.Committer.User.Login),
-			},
+	var assets []clients.ReleaseAsset
+	for _, a := range nodes {
+		assets = append(assets, clients.ReleaseAsset{
+			Name: string(a.Name),
+			URL:  string(a.URL),
 		})
 	}
-	return ret
+	return assets, nil
+}
+
+func (handler *graphqlHandler) getMergedPRs() ([]clients.PullRequest, error) {
+	if err := handler.setup(); err != nil {
+		return nil, fmt.Errorf("error during graphqlHandler.setup: %w", err)
+	}
+	return handler.prs, nil
+}
+
+func (handler *graphqlHandler) getCommits() ([]clients.Commit, error) {
+	if err := handler.setup(); err != nil {
+		return nil, fmt.Errorf("error during graphqlHandler.setup: %w", err)
+	}
+	return handler.commits, nil
+}
+
+func (handler *graphqlHandler) getReleases() ([]clients.Release, error) {
+	if err := handler.setup(); err != nil {
+		return nil, fmt.Errorf("error during graphqlHandler.setup: %w", err)
+	}
+	return handler.releases, nil
+}
+
+func (handler *graphqlHandler) isArchived() (bool, error) {
+	if err := handler.setup(); err != nil {
+		return false, fmt.Errorf("error during graphqlHandler.setup: %w", err)
+	}
+	return handler.archived, nil
 }
@@ -0,0 +1,287 @@
+// Copyright 2021 Security Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githubrepo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shurcooL/githubv4"
+
+	"github.com/ossf/scorecard/v2/clients"
+)
+
+// pagedPullRequestsTransport is a recorded-style http.RoundTripper: it
+// serves fixed, hand-written GraphQL responses for a repo with 45 merged
+// pull requests split across a 30-item first page and a 15-item second
+// page, so tests can assert that
+// graphqlHandler follows the cursor instead of stopping at the first page.
+type pagedPullRequestsTransport struct {
+	calls int
+}
+
+func prNode(number int) map[string]interface{} {
+	return map[string]interface{}{
+		"id":         fmt.Sprintf("PR_%d", number),
+		"number":     number,
+		"headRefOid": "deadbeef",
+		"mergeCommit": map[string]interface{}{
+			"authoredByCommitter": true,
+		},
+		"mergedAt": "2022-01-01T00:00:00Z",
+		"labels": map[string]interface{}{
+			"nodes":    []interface{}{},
+			"pageInfo": map[string]interface{}{"hasNextPage": false, "endCursor": ""},
+		},
+		"latestReviews": map[string]interface{}{
+			"nodes":    []interface{}{},
+			"pageInfo": map[string]interface{}{"hasNextPage": false, "endCursor": ""},
+		},
+	}
+}
+
+func (t *pagedPullRequestsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.calls++
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	const totalPRs = 45
+	const firstPage = 30
+
+	var nodes []interface{}
+	var pageInfo map[string]interface{}
+	var repository map[string]interface{}
+	switch {
+	case !strings.Contains(string(body), "after:"):
+		// The seed query (graphqlData): first page of every connection, no
+		// cursor yet.
+		for i := 1; i <= firstPage; i++ {
+			nodes = append(nodes, prNode(i))
+		}
+		pageInfo = map[string]interface{}{"hasNextPage": true, "endCursor": "cursor-1"}
+		repository = map[string]interface{}{
+			"isArchived": false,
+			"defaultBranchRef": map[string]interface{}{
+				"target": map[string]interface{}{
+					"history": map[string]interface{}{
+						"nodes":    []interface{}{},
+						"pageInfo": map[string]interface{}{"hasNextPage": false, "endCursor": ""},
+					},
+				},
+			},
+			"pullRequests": map[string]interface{}{
+				"nodes":    nodes,
+				"pageInfo": pageInfo,
+			},
+			"releases": map[string]interface{}{
+				"nodes":    []interface{}{},
+				"pageInfo": map[string]interface{}{"hasNextPage": false, "endCursor": ""},
+			},
+		}
+	default:
+		// The continuation query (pullRequestsQuery) only asks for the
+		// pullRequests connection, so the response must only contain that
+		// field: githubv4 rejects JSON keys it can't map onto the query.
+		for i := firstPage + 1; i <= totalPRs; i++ {
+			nodes = append(nodes, prNode(i))
+		}
+		pageInfo = map[string]interface{}{"hasNextPage": false, "endCursor": ""}
+		repository = map[string]interface{}{
+			"pullRequests": map[string]interface{}{
+				"nodes":    nodes,
+				"pageInfo": pageInfo,
+			},
+		}
+	}
+
+	resp := map[string]interface{}{
+		"data": map[string]interface{}{
+			"repository": repository,
+		},
+	}
+
+	payload, err := json.Marshal(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(bytes.NewReader(payload)),
+		Header:     make(http.Header),
+	}, nil
+}
+
+// TestGraphqlHandler_PaginatesBeyondThirtyMergedPRs proves that a repo with
+// more than 30 merged PRs gets all of them back, not just the historical
+// first-30 cutoff.
+func TestGraphqlHandler_PaginatesBeyondThirtyMergedPRs(t *testing.T) {
+	t.Parallel()
+
+	transport := &pagedPullRequestsTransport{}
+	httpClient := &http.Client{Transport: transport}
+
+	handler := &graphqlHandler{
+		client: githubv4.NewClient(httpClient),
+	}
+	handler.init(context.Background(), "owner", "repo", clients.WithMaxPullRequests(100))
+
+	prs, err := handler.getMergedPRs()
+	if err != nil {
+		t.Fatalf("getMergedPRs: %v", err)
+	}
+
+	if len(prs) != 45 {
+		t.Errorf("got %d merged PRs, want 45 (the historical cutoff would have returned 30)", len(prs))
+	}
+	if transport.calls < 2 {
+		t.Errorf("got %d request(s), want at least 2: the handler should have followed the pageInfo cursor", transport.calls)
+	}
+}
+
+// TestGraphqlHandler_DefaultCeilingPreservesHistoricalBehavior checks that
+// callers who don't opt in to a higher ceiling still see the old 30-item
+// cutoff, so existing checks aren't affected until they ask for more.
+func TestGraphqlHandler_DefaultCeilingPreservesHistoricalBehavior(t *testing.T) {
+	t.Parallel()
+
+	transport := &pagedPullRequestsTransport{}
+	httpClient := &http.Client{Transport: transport}
+
+	handler := &graphqlHandler{
+		client: githubv4.NewClient(httpClient),
+	}
+	handler.init(context.Background(), "owner", "repo")
+
+	prs, err := handler.getMergedPRs()
+	if err != nil {
+		t.Fatalf("getMergedPRs: %v", err)
+	}
+
+	if len(prs) != defaultPullRequestsToAnalyze {
+		t.Errorf("got %d merged PRs, want %d", len(prs), defaultPullRequestsToAnalyze)
+	}
+}
+
+// commitsLookbackTransport serves a single page of 40 commits, newest
+// first, one per day going back 39 days, so tests can assert on where
+// clients.WithLookbackDuration cuts off the (newest-first) history.
+type commitsLookbackTransport struct {
+	calls int
+}
+
+func commitNodeAt(daysAgo int) map[string]interface{} {
+	return map[string]interface{}{
+		"committedDate": time.Now().AddDate(0, 0, -daysAgo).UTC().Format(time.RFC3339),
+		"message":       fmt.Sprintf("commit %d", daysAgo),
+		"oid":           fmt.Sprintf("sha-%d", daysAgo),
+		"committer": map[string]interface{}{
+			"user": map[string]interface{}{"login": "someone"},
+		},
+	}
+}
+
+func (t *commitsLookbackTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.calls++
+
+	const totalCommits = 40
+	var nodes []interface{}
+	for i := 0; i < totalCommits; i++ {
+		nodes = append(nodes, commitNodeAt(i))
+	}
+
+	resp := map[string]interface{}{
+		"data": map[string]interface{}{
+			"repository": map[string]interface{}{
+				"isArchived": false,
+				"defaultBranchRef": map[string]interface{}{
+					"target": map[string]interface{}{
+						"history": map[string]interface{}{
+							"nodes":    nodes,
+							"pageInfo": map[string]interface{}{"hasNextPage": true, "endCursor": "cursor-1"},
+						},
+					},
+				},
+				"pullRequests": map[string]interface{}{
+					"nodes":    []interface{}{},
+					"pageInfo": map[string]interface{}{"hasNextPage": false, "endCursor": ""},
+				},
+				"releases": map[string]interface{}{
+					"nodes":    []interface{}{},
+					"pageInfo": map[string]interface{}{"hasNextPage": false, "endCursor": ""},
+				},
+			},
+		},
+	}
+
+	payload, err := json.Marshal(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(bytes.NewReader(payload)),
+		Header:     make(http.Header),
+	}, nil
+}
+
+// TestGraphqlHandler_LookbackCutoffTrimsOlderCommits proves that
+// clients.WithLookbackDuration both trims commits older than the cutoff
+// from the result and stops paginating once the oldest commit fetched so
+// far is already past it, instead of walking the rest of the (still
+// further-paginatable) history.
+func TestGraphqlHandler_LookbackCutoffTrimsOlderCommits(t *testing.T) {
+	t.Parallel()
+
+	transport := &commitsLookbackTransport{}
+	httpClient := &http.Client{Transport: transport}
+
+	handler := &graphqlHandler{
+		client: githubv4.NewClient(httpClient),
+	}
+	// The commit ceiling is raised above the 40 commits served, so that only
+	// the lookback cutoff, not the default 30-commit ceiling, can stop
+	// pagination after the first request.
+	handler.init(context.Background(), "owner", "repo",
+		clients.WithLookbackDuration(9*24*time.Hour+12*time.Hour), clients.WithMaxCommits(100))
+
+	commits, err := handler.getCommits()
+	if err != nil {
+		t.Fatalf("getCommits: %v", err)
+	}
+
+	// Commits 0..9 days ago (10 commits) fall within a 9.5-day lookback;
+	// commits 10..39 days ago don't. The half-day offset avoids the test
+	// being sensitive to a commit landing exactly on the cutoff.
+	const wantCommits = 10
+	if len(commits) != wantCommits {
+		t.Errorf("got %d commits, want %d", len(commits), wantCommits)
+	}
+	if transport.calls != 1 {
+		t.Errorf("got %d request(s), want exactly 1: pagination should have stopped once the oldest "+
+			"fetched commit was already past the lookback cutoff", transport.calls)
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2021 Security Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clients
+
+import "time"
+
+// RepoClientOptions controls how many items of each kind a RepoClient will
+// fetch from paginated connections (merged pull requests, commits,
+// releases, and their nested labels/reviews/assets) before it stops,
+// letting callers trade completeness for API cost. A zero value for any
+// field means "use the client's default", which preserves prior behavior.
+type RepoClientOptions struct {
+	MaxCommits       int
+	MaxPullRequests  int
+	MaxReleases      int
+	MaxLabels        int
+	MaxReviews       int
+	MaxReleaseAssets int
+	// LookbackDuration additionally stops commit pagination once a commit's
+	// CommittedDate falls outside this window from now. Zero means no
+	// time-based cutoff is applied.
+	LookbackDuration time.Duration
+}
+
+// RepoClientOption configures a RepoClientOptions value.
+type RepoClientOption func(*RepoClientOptions)
+
+// WithMaxCommits caps how many commits are fetched from the default
+// branch's history.
+func WithMaxCommits(n int) RepoClientOption {
+	return func(o *RepoClientOptions) { o.MaxCommits = n }
+}
+
+// WithMaxPullRequests caps how many merged pull requests are fetched.
+func WithMaxPullRequests(n int) RepoClientOption {
+	return func(o *RepoClientOptions) { o.MaxPullRequests = n }
+}
+
+// WithMaxReleases caps how many releases are fetched.
+func WithMaxReleases(n int) RepoClientOption {
+	return func(o *RepoClientOptions) { o.MaxReleases = n }
+}
+
+// WithMaxLabels caps how many labels are fetched per pull request.
+func WithMaxLabels(n int) RepoClientOption {
+	return func(o *RepoClientOptions) { o.MaxLabels = n }
+}
+
+// WithMaxReviews caps how many reviews are fetched per pull request.
+func WithMaxReviews(n int) RepoClientOption {
+	return func(o *RepoClientOptions) { o.MaxReviews = n }
+}
+
+// WithMaxReleaseAssets caps how many assets are fetched per release.
+func WithMaxReleaseAssets(n int) RepoClientOption {
+	return func(o *RepoClientOptions) { o.MaxReleaseAssets = n }
+}
+
+// WithLookbackDuration stops commit pagination once commits fall outside
+// the given window from now.
+func WithLookbackDuration(d time.Duration) RepoClientOption {
+	return func(o *RepoClientOptions) { o.LookbackDuration = d }
+}
+
+// Apply folds each RepoClientOption into a RepoClientOptions value.
+func Apply(opts ...RepoClientOption) RepoClientOptions {
+	var o RepoClientOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
@@ -0,0 +1,29 @@
+// Copyright 2021 Security Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clients
+
+// RepoClient is the subset of repository operations backed by a
+// pagination-aware client implementation (see githubrepo.CreateGithubRepoClient).
+// InitRepo takes RepoClientOption values so callers can raise the default
+// pagination ceilings (WithMaxCommits, WithMaxPullRequests, ...) or bound
+// commit history by age (WithLookbackDuration) instead of accepting the
+// historical 30-item cutoff.
+type RepoClient interface {
+	InitRepo(owner, repo string, opts ...RepoClientOption) error
+	ListCommits() ([]Commit, error)
+	ListMergedPRs() ([]PullRequest, error)
+	ListReleases() ([]Release, error)
+	IsArchived() (bool, error)
+}